@@ -5,7 +5,6 @@ go 1.14
 require (
 	github.com/fatih/structs v1.1.0
 	github.com/joaojeronimo/go-crc16 v0.0.0-20140729130949-59bd0194935e
-	github.com/mfiumara/mynewt-newtmgr v0.0.0-20200314173134-6a634a69efff
 	github.com/mitchellh/go-homedir v1.1.0
 	github.com/pkg/errors v0.8.1
 	github.com/rigado/ble v0.5.1
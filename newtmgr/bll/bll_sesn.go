@@ -31,14 +31,14 @@ import (
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/nmutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/bledefs"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/mgmt"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmble"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmcoap"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmp"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmxutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/nmutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/bledefs"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/mgmt"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmble"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmcoap"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmp"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmxutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
 	"mynewt.apache.org/newt/util"
 )
 
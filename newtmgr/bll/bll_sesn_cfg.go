@@ -26,8 +26,8 @@ import (
 
 	"github.com/rigado/ble"
 
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmcoap"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmcoap"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
 )
 
 type BllSesnCfg struct {
@@ -24,8 +24,8 @@ package bll
 import (
 	"time"
 
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmcoap"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmcoap"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
 )
 
 type BllSesnCfg struct {
@@ -24,8 +24,8 @@ package bll
 import (
 	"fmt"
 
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/bledefs"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/bledefs"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
 	"github.com/rigado/ble"
 	"github.com/rigado/ble/examples/lib/dev"
 )
@@ -26,7 +26,7 @@ import (
 	"github.com/rigado/ble/linux"
 	"github.com/rigado/ble/linux/hci/cmd"
 
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/bledefs"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/bledefs"
 	"mynewt.apache.org/newt/util"
 )
 
@@ -24,7 +24,7 @@ package bll
 import (
 	"github.com/rigado/ble"
 
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/bledefs"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/bledefs"
 )
 
 // macOS (CoreBluetooth) does not allow the connection parameters to be
@@ -25,8 +25,8 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/nmutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmxutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/nmutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmxutil"
 	"mynewt.apache.org/newt/util"
 )
 
@@ -24,16 +24,16 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/bll"
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/config"
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/nmutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/mtech_lora"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmble"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmcoap"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmserial"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/udp"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/xport"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/bll"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/config"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/nmutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/mtech_lora"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmble"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmcoap"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmserial"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/udp"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/xport"
 	"mynewt.apache.org/newt/util"
 )
 
@@ -24,9 +24,9 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/nmutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/xact"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/nmutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/xact"
 	"mynewt.apache.org/newt/util"
 )
 
@@ -67,7 +67,7 @@ func configWrite(s sesn.Sesn, args []string) {
 	}
 }
 
-func configSave(s sesn.Sesn, args []string) {
+func configSave(s sesn.Sesn) {
 	c := xact.NewConfigWriteCmd()
 	c.SetTxOptions(nmutil.TxOptions())
 	c.Save = true
@@ -93,7 +93,7 @@ func configRunCmd(cmd *cobra.Command, args []string) {
 
 	if len(args) == 1 {
 		if args[0] == "save" {
-			configSave(s, args)
+			configSave(s)
 		} else {
 			configRead(s, args)
 		}
@@ -23,8 +23,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/config"
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/nmutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/config"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/nmutil"
 	"mynewt.apache.org/newt/util"
 
 	"github.com/spf13/cobra"
@@ -26,9 +26,9 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/nmutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmp"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/xact"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/nmutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmp"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/xact"
 	"mynewt.apache.org/newt/util"
 )
 
@@ -112,6 +112,63 @@ func fsUploadRunCmd(cmd *cobra.Command, args []string) {
 	fmt.Printf("Done\n")
 }
 
+func fsStatRunCmd(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		nmUsage(cmd, nil)
+	}
+
+	s, err := GetSesn()
+	if err != nil {
+		nmUsage(nil, err)
+	}
+
+	c := xact.NewFsStatCmd()
+	c.SetTxOptions(nmutil.TxOptions())
+	c.Name = args[0]
+
+	res, err := c.Run(s)
+	if err != nil {
+		nmUsage(nil, util.ChildNewtError(err))
+	}
+
+	sres := res.(*xact.FsStatResult)
+	if sres.Rsp.Rc != 0 {
+		fmt.Printf("Error: %d\n", sres.Rsp.Rc)
+		return
+	}
+
+	fmt.Printf("name: %s\n", args[0])
+	fmt.Printf("size: %d\n", sres.Rsp.Len)
+}
+
+// Runs a file system shell command on the device and prints its output.
+func fsShellRun(argv []string) {
+	rsp := shellRun(argv)
+	shellPrintOutput(rsp.O)
+	if rsp.Rc != 0 {
+		fmt.Printf("Error: %d\n", rsp.Rc)
+	}
+}
+
+// The file system management group has no directory listing or removal
+// commands.  These are implemented by running the device's file system shell
+// commands remotely.
+func fsLsRunCmd(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		nmUsage(cmd, nil)
+	}
+
+	fsShellRun([]string{"ls", args[0]})
+}
+
+func fsRmRunCmd(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		nmUsage(cmd, nil)
+	}
+
+	fsShellRun([]string{"rm", args[0]})
+}
+
 func fsCmd() *cobra.Command {
 	fsCmd := &cobra.Command{
 		Use:   "fs",
@@ -143,5 +200,44 @@ func fsCmd() *cobra.Command {
 	}
 	fsCmd.AddCommand(downloadCmd)
 
+	statEx := "  " + nmutil.ToolInfo.ExeName +
+		" -c olimex fs stat /cfg/mfg\n"
+
+	statCmd := &cobra.Command{
+		Use:     "stat <filename> -c <conn_profile>",
+		Short:   "Show the size of a file on a device",
+		Example: statEx,
+		Run:     fsStatRunCmd,
+	}
+	fsCmd.AddCommand(statCmd)
+
+	fsShellHelpText := "This command runs the device's file system shell " +
+		"command remotely;\nthe device must support shell management and " +
+		"have the file system CLI\nenabled."
+
+	lsEx := "  " + nmutil.ToolInfo.ExeName +
+		" -c olimex fs ls /cfg\n"
+
+	lsCmd := &cobra.Command{
+		Use:     "ls <dirname> -c <conn_profile>",
+		Short:   "List a directory on a device",
+		Long:    fsShellHelpText,
+		Example: lsEx,
+		Run:     fsLsRunCmd,
+	}
+	fsCmd.AddCommand(lsCmd)
+
+	rmEx := "  " + nmutil.ToolInfo.ExeName +
+		" -c olimex fs rm /cfg/mfg\n"
+
+	rmCmd := &cobra.Command{
+		Use:     "rm <filename> -c <conn_profile>",
+		Short:   "Remove a file from a device",
+		Long:    fsShellHelpText,
+		Example: rmEx,
+		Run:     fsRmRunCmd,
+	}
+	fsCmd.AddCommand(rmCmd)
+
 	return fsCmd
 }
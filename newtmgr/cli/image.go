@@ -20,19 +20,23 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"strings"
 
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	pb "gopkg.in/cheggaaa/pb.v1"
 
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/core"
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/nmutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmp"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/xact"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/core"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/nmutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmp"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/xact"
 	"mynewt.apache.org/newt/util"
 )
 
@@ -45,6 +49,16 @@ var (
 var noerase bool
 var upgrade bool
 var imageNum int
+var imageForce bool
+var imageMaxChunk int
+
+const (
+	imageMagic            = 0x96f3b83d
+	imageHeaderSize       = 32
+	imageTlvInfoMagic     = 0x6907
+	imageTlvProtInfoMagic = 0x6908
+	imageTlvTypeSha256    = 0x10
+)
 
 func imageFlagsStr(image nmp.ImageStateEntry) string {
 	strs := []string{}
@@ -88,6 +102,88 @@ func imageStatePrintRsp(rsp *nmp.ImageStateRsp) error {
 	return nil
 }
 
+// Extracts the SHA256 hash from an image file's TLV trailer.  This is the
+// same hash the device reports in its image list.
+func imageFileHash(data []byte) ([]byte, error) {
+	if len(data) < imageHeaderSize {
+		return nil, util.NewNewtError("Image file too short")
+	}
+
+	if binary.LittleEndian.Uint32(data[0:4]) != imageMagic {
+		return nil, util.NewNewtError("Image file has invalid magic")
+	}
+
+	hdrSz := int(binary.LittleEndian.Uint16(data[8:10]))
+	imgSz := int(binary.LittleEndian.Uint32(data[12:16]))
+
+	// The protected TLV area, if present, precedes the unprotected one.
+	off := hdrSz + imgSz
+	for off+4 <= len(data) {
+		magic := binary.LittleEndian.Uint16(data[off : off+2])
+		if magic != imageTlvInfoMagic && magic != imageTlvProtInfoMagic {
+			break
+		}
+
+		end := off + int(binary.LittleEndian.Uint16(data[off+2:off+4]))
+		if end > len(data) {
+			return nil, util.NewNewtError("Image file has truncated TLVs")
+		}
+
+		for tlvOff := off + 4; tlvOff+4 <= end; {
+			tlvType := binary.LittleEndian.Uint16(data[tlvOff : tlvOff+2])
+			tlvLen := int(binary.LittleEndian.Uint16(data[tlvOff+2 : tlvOff+4]))
+
+			valOff := tlvOff + 4
+			if valOff+tlvLen > end {
+				return nil, util.NewNewtError("Image file has truncated TLVs")
+			}
+
+			if tlvType == imageTlvTypeSha256 {
+				return data[valOff : valOff+tlvLen], nil
+			}
+
+			tlvOff = valOff + tlvLen
+		}
+
+		off = end
+	}
+
+	return nil, util.NewNewtError("Image file does not contain a hash")
+}
+
+// Looks for an image on the device with the same hash as the specified image
+// file.  Returns nil if no such image is present or if the comparison cannot
+// be made.
+func imageFindOnDevice(s sesn.Sesn, imageFile []byte) *nmp.ImageStateEntry {
+	hash, err := imageFileHash(imageFile)
+	if err != nil {
+		log.Debugf("Not checking device for image: %s", err.Error())
+		return nil
+	}
+
+	c := xact.NewImageStateReadCmd()
+	c.SetTxOptions(nmutil.TxOptions())
+
+	res, err := c.Run(s)
+	if err != nil {
+		log.Debugf("Not checking device for image: %s", err.Error())
+		return nil
+	}
+	ires := res.(*xact.ImageStateReadResult)
+	if ires.Rsp.Rc != 0 {
+		log.Debugf("Not checking device for image: rc=%d", ires.Rsp.Rc)
+		return nil
+	}
+
+	for i, img := range ires.Rsp.Images {
+		if img.Image == imageNum && bytes.Equal(img.Hash, hash) {
+			return &ires.Rsp.Images[i]
+		}
+	}
+
+	return nil
+}
+
 func imageStateListCmd(cmd *cobra.Command, args []string) {
 	s, err := GetSesn()
 	if err != nil {
@@ -196,6 +292,20 @@ func imageUploadCmd(cmd *cobra.Command, args []string) {
 	}
 	c.ImageNum = imageNum
 	c.Upgrade = upgrade
+	if imageMaxChunk < 0 {
+		nmUsage(cmd, util.NewNewtError("Invalid maximum chunk size"))
+	}
+	c.MaxChunkLen = imageMaxChunk
+
+	if !imageForce {
+		if img := imageFindOnDevice(s, imageFile); img != nil {
+			fmt.Printf("Image already present on device (image=%d slot=%d); "+
+				"skipping upload (use --force to override)\n",
+				img.Image, img.Slot)
+			return
+		}
+	}
+
 	c.ProgressBar = pb.StartNew(len(imageFile))
 	c.ProgressBar.SetUnits(pb.U_BYTES)
 	c.ProgressBar.ShowSpeed = true
@@ -411,6 +521,13 @@ func imageCmd() *cobra.Command {
 	uploadCmd.PersistentFlags().IntVarP(&imageNum,
 		"image", "n", 0,
 		"In a multi-image system, which image should be uploaded")
+	uploadCmd.PersistentFlags().BoolVarP(&imageForce,
+		"force", "f", false,
+		"Upload the image even if the device already contains it")
+	uploadCmd.PersistentFlags().IntVar(&imageMaxChunk,
+		"max-chunk", 0,
+		"Maximum number of image bytes per upload request; set this to "+
+			"fit the device's buffer size (0 = default)")
 	imageCmd.AddCommand(uploadCmd)
 
 	coreListCmd := &cobra.Command{
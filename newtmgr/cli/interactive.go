@@ -26,11 +26,11 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/nmutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmcoap"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmxutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/xact"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/nmutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmcoap"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmxutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/xact"
 	"github.com/runtimeco/go-coap"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
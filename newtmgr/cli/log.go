@@ -28,11 +28,11 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/nmutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmp"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmxutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/xact"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/nmutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmp"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmxutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/xact"
 	"mynewt.apache.org/newt/util"
 )
 
@@ -22,13 +22,16 @@ package cli
 import (
 	"fmt"
 
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/nmutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/xact"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/nmutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmp"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/xact"
 	"github.com/spf13/cobra"
 	"mynewt.apache.org/newt/util"
 )
 
-func shellExecCmd(cmd *cobra.Command, args []string) {
+// Executes a shell command on the device and returns its response.  Used by
+// commands that are implemented on top of the device's shell.
+func shellRun(argv []string) *nmp.ShellExecRsp {
 	s, err := GetSesn()
 	if err != nil {
 		nmUsage(nil, err)
@@ -36,28 +39,36 @@ func shellExecCmd(cmd *cobra.Command, args []string) {
 
 	c := xact.NewShellExecCmd()
 	c.SetTxOptions(nmutil.TxOptions())
-
-	if len(args) == 0 {
-		nmUsage(cmd, nil)
-	}
-
-	c.Argv = args
+	c.Argv = argv
 
 	res, err := c.Run(s)
 	if err != nil {
 		nmUsage(nil, util.ChildNewtError(err))
 	}
 
-	sres := res.(*xact.ShellExecResult)
-	fmt.Printf("status=%d\n", sres.Rsp.Rc)
-	if len(sres.Rsp.O) > 0 {
-		fmt.Printf("%s", sres.Rsp.O)
-		if sres.Rsp.O[len(sres.Rsp.O)-1] != '\n' {
+	return res.(*xact.ShellExecResult).Rsp
+}
+
+// Prints the output of a shell command, terminated by a newline.
+func shellPrintOutput(o string) {
+	if len(o) > 0 {
+		fmt.Printf("%s", o)
+		if o[len(o)-1] != '\n' {
 			fmt.Printf("\n")
 		}
 	}
 }
 
+func shellExecCmd(cmd *cobra.Command, args []string) {
+	if len(args) == 0 {
+		nmUsage(cmd, nil)
+	}
+
+	rsp := shellRun(args)
+	fmt.Printf("status=%d\n", rsp.Rc)
+	shellPrintOutput(rsp.O)
+}
+
 func shellCmd() *cobra.Command {
 	shellCmd := &cobra.Command{
 		Use:   "shell",
@@ -25,37 +25,72 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/nmutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/xact"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/nmutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmp"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/xact"
 	"mynewt.apache.org/newt/util"
 )
 
-func statsListRunCmd(cmd *cobra.Command, args []string) {
-	s, err := GetSesn()
-	if err != nil {
-		nmUsage(nil, err)
-	}
+var statDumpAll bool
 
+// Reads the sorted list of stat group names from a device.
+func statsListGroups(s sesn.Sesn) ([]string, int, error) {
 	c := xact.NewStatListCmd()
 	c.SetTxOptions(nmutil.TxOptions())
 
 	res, err := c.Run(s)
 	if err != nil {
-		nmUsage(nil, util.ChildNewtError(err))
+		return nil, 0, util.ChildNewtError(err)
 	}
 
 	sres := res.(*xact.StatListResult)
 	if sres.Rsp.Rc != 0 {
-		fmt.Printf("Error: %d\n", sres.Rsp.Rc)
-	} else if len(sres.Rsp.List) == 0 {
-		fmt.Printf("stat groups: none\n")
+		return nil, sres.Rsp.Rc, nil
+	}
+
+	groups := make([]string, len(sres.Rsp.List))
+	for i, g := range sres.Rsp.List {
+		groups[i] = g
+	}
+	sort.Strings(groups)
+
+	return groups, 0, nil
+}
+
+func statsPrintRsp(rsp *nmp.StatReadRsp) {
+	fmt.Printf("stat group: %s\n", rsp.Name)
+	if len(rsp.Fields) == 0 {
+		fmt.Printf("    (empty)\n")
 	} else {
-		groups := make([]string, len(sres.Rsp.List))
-		for i, g := range sres.Rsp.List {
-			groups[i] = g
+		names := make([]string, 0, len(rsp.Fields))
+		for k, _ := range rsp.Fields {
+			names = append(names, k)
 		}
-		sort.Strings(groups)
+		sort.Strings(names)
+
+		for _, n := range names {
+			fmt.Printf("%10d %s\n", rsp.Fields[n], n)
+		}
+	}
+}
+
+func statsListRunCmd(cmd *cobra.Command, args []string) {
+	s, err := GetSesn()
+	if err != nil {
+		nmUsage(nil, err)
+	}
+
+	groups, rc, err := statsListGroups(s)
+	if err != nil {
+		nmUsage(nil, err)
+	}
 
+	if rc != 0 {
+		fmt.Printf("Error: %d\n", rc)
+	} else if len(groups) == 0 {
+		fmt.Printf("stat groups: none\n")
+	} else {
 		fmt.Printf("stat groups:\n")
 		for _, g := range groups {
 			fmt.Printf("    %s\n", g)
@@ -86,19 +121,57 @@ func statsRunCmd(cmd *cobra.Command, args []string) {
 	if sres.Rsp.Rc != 0 {
 		fmt.Printf("Error: %d\n", sres.Rsp.Rc)
 	} else {
-		fmt.Printf("stat group: %s\n", sres.Rsp.Name)
-		if len(sres.Rsp.Fields) == 0 {
-			fmt.Printf("    (empty)\n")
+		statsPrintRsp(sres.Rsp)
+	}
+}
+
+func statsDumpRunCmd(cmd *cobra.Command, args []string) {
+	if !statDumpAll && len(args) < 1 {
+		nmUsage(cmd, nil)
+	}
+	if statDumpAll && len(args) > 0 {
+		nmUsage(cmd, util.NewNewtError(
+			"--all cannot be combined with named stats groups"))
+	}
+
+	s, err := GetSesn()
+	if err != nil {
+		nmUsage(nil, err)
+	}
+
+	groups := args
+	if statDumpAll {
+		var rc int
+		groups, rc, err = statsListGroups(s)
+		if err != nil {
+			nmUsage(nil, err)
+		}
+		if rc != 0 {
+			fmt.Printf("Error: %d\n", rc)
+			return
+		}
+	}
+
+	for i, g := range groups {
+		if i > 0 {
+			fmt.Printf("\n")
+		}
+
+		c := xact.NewStatReadCmd()
+		c.SetTxOptions(nmutil.TxOptions())
+		c.Name = g
+
+		res, err := c.Run(s)
+		if err != nil {
+			nmUsage(nil, util.ChildNewtError(err))
+		}
+
+		sres := res.(*xact.StatReadResult)
+		if sres.Rsp.Rc != 0 {
+			fmt.Printf("stat group: %s\n", g)
+			fmt.Printf("    Error: %d\n", sres.Rsp.Rc)
 		} else {
-			names := make([]string, 0, len(sres.Rsp.Fields))
-			for k, _ := range sres.Rsp.Fields {
-				names = append(names, k)
-			}
-			sort.Strings(names)
-
-			for _, n := range names {
-				fmt.Printf("%10d %s\n", sres.Rsp.Fields[n], n)
-			}
+			statsPrintRsp(sres.Rsp)
 		}
 	}
 }
@@ -120,5 +193,19 @@ func statsCmd() *cobra.Command {
 
 	statsCmd.AddCommand(ListCmd)
 
+	dumpEx := "  " + nmutil.ToolInfo.ExeName + " stat dump ble_att ble_gap -c myserial\n"
+	dumpEx += "  " + nmutil.ToolInfo.ExeName + " stat dump --all -c myserial\n"
+
+	DumpCmd := &cobra.Command{
+		Use:     "dump [stats_name...] -c <conn_profile>",
+		Short:   "Read statistics for several Stats from a device",
+		Example: dumpEx,
+		Run:     statsDumpRunCmd,
+	}
+	DumpCmd.PersistentFlags().BoolVarP(&statDumpAll, "all", "a", false,
+		"read every Stats registered on the device")
+
+	statsCmd.AddCommand(DumpCmd)
+
 	return statsCmd
 }
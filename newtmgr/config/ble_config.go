@@ -21,14 +21,15 @@ package config
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/nmutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/bledefs"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmble"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/xport"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/nmutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/bledefs"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmble"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/xport"
 	"mynewt.apache.org/newt/util"
 )
 
@@ -47,6 +48,10 @@ type BleConfig struct {
 	ControllerPath string
 
 	HciIdx int
+
+	// Security.
+	EncryptWhen bledefs.BleEncryptWhen
+	Passkey     *uint32
 }
 
 func NewBleConfig() *BleConfig {
@@ -58,10 +63,52 @@ func NewBleConfig() *BleConfig {
 }
 
 func einvalBleConnString(f string, args ...interface{}) error {
-	suffix := fmt.Sprintf(f, args)
+	suffix := fmt.Sprintf(f, args...)
 	return util.FmtNewtError("Invalid BLE connstring; %s", suffix)
 }
 
+// Handles user interaction during BLE pairing.  A passkey configured in the
+// connection profile is used for passkey entry; otherwise, the user is
+// prompted.
+func blePasskey(bc *BleConfig, action bledefs.BleSmAction,
+	passkey uint32) (uint32, bool, error) {
+
+	switch action {
+	case bledefs.BLE_SM_ACTION_INPUT:
+		if bc.Passkey != nil {
+			return *bc.Passkey, false, nil
+		}
+
+		var s string
+		fmt.Printf("Enter passkey: ")
+		if _, err := fmt.Scanln(&s); err != nil {
+			return 0, false, util.ChildNewtError(err)
+		}
+		pk, err := strconv.ParseUint(strings.TrimSpace(s), 10, 32)
+		if err != nil || pk > 999999 {
+			return 0, false, util.FmtNewtError("Invalid passkey: %s", s)
+		}
+		return uint32(pk), false, nil
+
+	case bledefs.BLE_SM_ACTION_DISP:
+		fmt.Printf("Enter passkey on device: %06d\n", passkey)
+		return 0, false, nil
+
+	case bledefs.BLE_SM_ACTION_NUMCMP:
+		var s string
+		fmt.Printf("Does the device display %06d? (y/n) ", passkey)
+		if _, err := fmt.Scanln(&s); err != nil {
+			return 0, false, util.ChildNewtError(err)
+		}
+		s = strings.ToLower(strings.TrimSpace(s))
+		return 0, s == "y" || s == "yes", nil
+
+	default:
+		return 0, false, util.FmtNewtError(
+			"Unsupported pairing action: %s", action.String())
+	}
+}
+
 func ParseBleConnString(cs string) (*BleConfig, error) {
 	bc := NewBleConfig()
 
@@ -106,6 +153,18 @@ func ParseBleConnString(cs string) (*BleConfig, error) {
 			bc.BlehostdPath = v
 		case "ctlr_path":
 			bc.ControllerPath = v
+		case "encrypt":
+			bc.EncryptWhen, err = bledefs.BleEncryptWhenFromString(v)
+			if err != nil {
+				return nil, einvalBleConnString("Invalid encrypt: %s", v)
+			}
+		case "passkey":
+			pk, err := strconv.ParseUint(v, 10, 32)
+			if err != nil || pk > 999999 {
+				return nil, einvalBleConnString("Invalid passkey: %s", v)
+			}
+			passkey := uint32(pk)
+			bc.Passkey = &passkey
 		default:
 			return nil, einvalBleConnString("Unrecognized key: %s", k)
 		}
@@ -118,6 +177,12 @@ func ParseBleConnString(cs string) (*BleConfig, error) {
 
 func FillSesnCfg(bx *nmble.BleXport, bc *BleConfig, sc *sesn.SesnCfg) error {
 	sc.Ble.OwnAddrType = bc.OwnAddrType
+	sc.Ble.EncryptWhen = bc.EncryptWhen
+	sc.Ble.PasskeyCb = func(action bledefs.BleSmAction, passkey uint32) (
+		uint32, bool, error) {
+
+		return blePasskey(bc, action, passkey)
+	}
 
 	if nmutil.DeviceName != "" {
 		bc.PeerName = nmutil.DeviceName
@@ -152,6 +217,12 @@ func FillSesnCfg(bx *nmble.BleXport, bc *BleConfig, sc *sesn.SesnCfg) error {
 
 	sc.Ble.WriteRsp = nmutil.BleWriteRsp
 
+	// The peer only reports that it requires security in response to a
+	// write request, so encrypting as required needs write responses.
+	if bc.EncryptWhen == bledefs.BLE_ENCRYPT_AS_REQD {
+		sc.Ble.WriteRsp = true
+	}
+
 	return nil
 }
 
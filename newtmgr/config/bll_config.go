@@ -29,9 +29,9 @@ import (
 
 	"github.com/rigado/ble"
 
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/bll"
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/nmutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/bledefs"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/bll"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/nmutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/bledefs"
 	"mynewt.apache.org/newt/util"
 )
 
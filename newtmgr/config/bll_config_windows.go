@@ -24,8 +24,8 @@ package config
 import (
 	"fmt"
 
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/bll"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/bledefs"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/bll"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/bledefs"
 	"mynewt.apache.org/newt/util"
 )
 
@@ -30,7 +30,7 @@ import (
 	"path/filepath"
 	"sort"
 
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/nmutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/nmutil"
 	"mynewt.apache.org/newt/util"
 )
 
@@ -23,10 +23,10 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/nmutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/lora"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/mtech_lora"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/nmutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/lora"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/mtech_lora"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
 	"mynewt.apache.org/newt/util"
 )
 
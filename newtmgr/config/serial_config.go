@@ -21,19 +21,29 @@ package config
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/nmutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmserial"
+	"github.com/tarm/serial"
+
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/nmutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmserial"
 	"mynewt.apache.org/newt/util"
 )
 
 func einvalSerialConnString(f string, args ...interface{}) error {
-	suffix := fmt.Sprintf(f, args)
+	suffix := fmt.Sprintf(f, args...)
 	return util.FmtNewtError("Invalid serial connstring; %s", suffix)
 }
 
+// Prints device console output that shares the UART with management traffic.
+// The output goes to stderr so that it doesn't mix with command output.
+func serialPrintConsole(line []byte) {
+	fmt.Fprintf(os.Stderr, "%s\n", line)
+}
+
 func ParseSerialConnString(cs string) (*nmserial.XportCfg, error) {
 	sc := nmserial.NewXportCfg()
 	sc.Baud = 115200
@@ -68,6 +78,53 @@ func ParseSerialConnString(cs string) (*nmserial.XportCfg, error) {
 				return sc, einvalSerialConnString("Invalid mtu: %s", v)
 			}
 
+		case "databits":
+			var err error
+			sc.DataBits, err = strconv.Atoi(v)
+			if err != nil || sc.DataBits < 5 || sc.DataBits > 8 {
+				return sc, einvalSerialConnString("Invalid databits: %s", v)
+			}
+
+		case "parity":
+			switch strings.ToLower(v) {
+			case "none":
+				sc.Parity = serial.ParityNone
+			case "odd":
+				sc.Parity = serial.ParityOdd
+			case "even":
+				sc.Parity = serial.ParityEven
+			default:
+				return sc, einvalSerialConnString("Invalid parity: %s", v)
+			}
+
+		case "stopbits":
+			switch v {
+			case "1":
+				sc.StopBits = serial.Stop1
+			case "2":
+				sc.StopBits = serial.Stop2
+			default:
+				return sc, einvalSerialConnString("Invalid stopbits: %s", v)
+			}
+
+		case "frame_delay":
+			ms, err := strconv.Atoi(v)
+			if err != nil || ms < 0 {
+				return sc, einvalSerialConnString("Invalid frame_delay: %s", v)
+			}
+			sc.FrameDelay = time.Duration(ms) * time.Millisecond
+
+		case "console":
+			console, err := strconv.ParseBool(v)
+			if err != nil {
+				return sc, einvalSerialConnString("Invalid console: %s", v)
+			}
+			if console {
+				sc.ConsoleCb = serialPrintConsole
+			} else {
+				sc.ConsoleCb = nil
+			}
+
 		default:
 			return sc, einvalSerialConnString("Unrecognized key: %s", k)
 		}
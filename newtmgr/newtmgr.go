@@ -25,10 +25,10 @@ import (
 	"os/signal"
 	"syscall"
 
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/cli"
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/config"
-	"github.com/mfiumara/mynewt-newtmgr/newtmgr/nmutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmserial"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/cli"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/config"
+	"github.com/mfiumara/mynewt-newtmgr/v2/newtmgr/nmutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmserial"
 	"mynewt.apache.org/newt/util"
 )
 
@@ -672,6 +672,36 @@ const (
 	BLE_ENCRYPT_ALWAYS
 )
 
+var BleEncryptWhenStringMap = map[BleEncryptWhen]string{
+	BLE_ENCRYPT_NEVER:   "never",
+	BLE_ENCRYPT_AS_REQD: "as_reqd",
+	BLE_ENCRYPT_ALWAYS:  "always",
+}
+
+func BleEncryptWhenToString(ew BleEncryptWhen) string {
+	s := BleEncryptWhenStringMap[ew]
+	if s == "" {
+		return "???"
+	}
+
+	return s
+}
+
+func BleEncryptWhenFromString(s string) (BleEncryptWhen, error) {
+	for ew, name := range BleEncryptWhenStringMap {
+		if s == name {
+			return ew, nil
+		}
+	}
+
+	return BleEncryptWhen(0),
+		fmt.Errorf("Invalid BleEncryptWhen string: %s", s)
+}
+
+func (ew BleEncryptWhen) String() string {
+	return BleEncryptWhenToString(ew)
+}
+
 type BleGattOp int
 
 const (
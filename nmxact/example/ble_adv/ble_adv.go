@@ -27,10 +27,10 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmble"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmxutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/xport"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmble"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmxutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/xport"
 	"mynewt.apache.org/newt/util"
 )
 
@@ -28,12 +28,12 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/bledefs"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmble"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmxutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/xact"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/xport"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/bledefs"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmble"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmxutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/xact"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/xport"
 	"mynewt.apache.org/newt/util"
 )
 
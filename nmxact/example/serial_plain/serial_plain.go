@@ -24,9 +24,9 @@ import (
 	"os"
 	"time"
 
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmserial"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/xact"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmserial"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/xact"
 )
 
 func main() {
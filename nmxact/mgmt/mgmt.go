@@ -22,9 +22,9 @@ package mgmt
 import (
 	"fmt"
 
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmp"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/omp"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmp"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/omp"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
 )
 
 func EncodeMgmt(s sesn.Sesn, m *nmp.NmpMsg) ([]byte, error) {
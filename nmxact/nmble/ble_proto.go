@@ -27,7 +27,7 @@ import (
 	"strconv"
 	"strings"
 
-	. "github.com/mfiumara/mynewt-newtmgr/nmxact/bledefs"
+	. "github.com/mfiumara/mynewt-newtmgr/v2/nmxact/bledefs"
 )
 
 type MsgOp int
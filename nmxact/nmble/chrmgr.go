@@ -22,7 +22,7 @@ package nmble
 import (
 	"fmt"
 
-	. "github.com/mfiumara/mynewt-newtmgr/nmxact/bledefs"
+	. "github.com/mfiumara/mynewt-newtmgr/v2/nmxact/bledefs"
 )
 
 type chrMgrElem struct {
@@ -24,7 +24,7 @@ import (
 	"fmt"
 	"sync"
 
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmxutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmxutil"
 
 	log "github.com/sirupsen/logrus"
 )
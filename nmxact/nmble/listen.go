@@ -23,7 +23,7 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmxutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmxutil"
 )
 
 type ListenerKey struct {
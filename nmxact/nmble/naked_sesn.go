@@ -20,20 +20,22 @@
 package nmble
 
 import (
+	"crypto/rand"
 	"fmt"
+	"math/big"
 	"sync"
 	"time"
 
 	"github.com/runtimeco/go-coap"
 	log "github.com/sirupsen/logrus"
 
-	. "github.com/mfiumara/mynewt-newtmgr/nmxact/bledefs"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/mgmt"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmcoap"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmp"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmxutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/task"
+	. "github.com/mfiumara/mynewt-newtmgr/v2/nmxact/bledefs"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/mgmt"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmcoap"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmp"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmxutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/task"
 	"mynewt.apache.org/newt/util"
 )
 
@@ -203,6 +205,16 @@ func (s *NakedSesn) initiateSecurity() error {
 	return nil
 }
 
+// Indicates whether the given error should be handled by encrypting the
+// connection.  This is only the case when the session is configured to
+// encrypt as required and the link is not already encrypted.
+func (s *NakedSesn) needsSecurity(err error) bool {
+	return err != nil &&
+		s.cfg.Ble.EncryptWhen == BLE_ENCRYPT_AS_REQD &&
+		IsSecErr(err) &&
+		!s.conn.ConnInfo().Encrypted
+}
+
 func (s *NakedSesn) Open() error {
 	initiate := func() error {
 		s.mtx.Lock()
@@ -332,7 +344,7 @@ func (s *NakedSesn) TxRxMgmt(m *nmp.NmpMsg,
 			return err
 		}
 
-		txRaw := func(b []byte) error {
+		write := func(b []byte) error {
 			if s.cfg.Ble.WriteRsp {
 				return s.conn.WriteChr(chr, b, "nmp")
 			} else {
@@ -340,6 +352,20 @@ func (s *NakedSesn) TxRxMgmt(m *nmp.NmpMsg,
 			}
 		}
 
+		txRaw := func(b []byte) error {
+			err := write(b)
+
+			// If the peer requires an encrypted link, establish one and
+			// retry.
+			if s.needsSecurity(err) {
+				if err := s.initiateSecurity(); err != nil {
+					return err
+				}
+				err = write(b)
+			}
+			return err
+		}
+
 		rsp, err = s.txvr.TxRxMgmt(txRaw, m, s.MtuOut(), timeout)
 		return err
 	}
@@ -483,9 +509,19 @@ func (s *NakedSesn) openOnce() (bool, error) {
 		return false, err
 	}
 
+	// Listen for authentication IO requests in the background.
+	s.smIoDemandListen()
+
 	if chr, _ := s.getChr(s.mgmtChrs.NmpRspChr); chr != nil {
 		if chr.SubscribeType() != 0 {
-			if err := s.conn.Subscribe(chr); err != nil {
+			err := s.conn.Subscribe(chr)
+			if s.needsSecurity(err) {
+				if err := s.initiateSecurity(); err != nil {
+					return false, err
+				}
+				err = s.conn.Subscribe(chr)
+			}
+			if err != nil {
 				return false, err
 			}
 		}
@@ -494,9 +530,6 @@ func (s *NakedSesn) openOnce() (bool, error) {
 	// Listen for incoming notifications in the background.
 	s.notifyListen()
 
-	// Listen for authentication IO requests in the background.
-	s.smIoDemandListen()
-
 	if s.cfg.Ble.EncryptWhen == BLE_ENCRYPT_ALWAYS {
 		if err := s.initiateSecurity(); err != nil {
 			return false, err
@@ -520,8 +553,34 @@ func (s *NakedSesn) smRespondIo(dmnd SmIoDemand) error {
 		io.Oob = s.smIo.Oob
 
 	case BLE_SM_ACTION_INPUT, BLE_SM_ACTION_DISP, BLE_SM_ACTION_NUMCMP:
-		return fmt.Errorf("Unsupported SM IO method requested: %s",
-			io.Action.String())
+		if s.cfg.Ble.PasskeyCb == nil {
+			return fmt.Errorf("Unsupported SM IO method requested: %s",
+				io.Action.String())
+		}
+
+		// For passkey display, we choose the passkey; the peer enters it.
+		passkey := dmnd.Numcmp
+		if dmnd.Action == BLE_SM_ACTION_DISP {
+			n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+			if err != nil {
+				return err
+			}
+			passkey = uint32(n.Int64())
+		}
+
+		pk, accept, err := s.cfg.Ble.PasskeyCb(dmnd.Action, passkey)
+		if err != nil {
+			return err
+		}
+
+		switch dmnd.Action {
+		case BLE_SM_ACTION_INPUT:
+			io.Passkey = pk
+		case BLE_SM_ACTION_DISP:
+			io.Passkey = passkey
+		case BLE_SM_ACTION_NUMCMP:
+			io.NumcmpAccept = accept
+		}
 
 	default:
 		return fmt.Errorf("Unknown SM IO method requested: %v", io.Action)
@@ -559,7 +618,10 @@ func (s *NakedSesn) smIoDemandListen() {
 				if ok {
 					log.Debugf("Received SM IO demand for %s",
 						dmnd.Action.String())
-					s.smRespondIo(dmnd)
+					if err := s.smRespondIo(dmnd); err != nil {
+						log.Debugf("Failed to respond to SM IO demand: %s",
+							err.Error())
+					}
 				}
 
 			case <-s.stopChan:
@@ -20,7 +20,7 @@
 package nmble
 
 import (
-	. "github.com/mfiumara/mynewt-newtmgr/nmxact/bledefs"
+	. "github.com/mfiumara/mynewt-newtmgr/v2/nmxact/bledefs"
 )
 
 type Descriptor struct {
@@ -24,7 +24,7 @@ import (
 	"sync"
 	"time"
 
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmxutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmxutil"
 )
 
 const syncPollRate = time.Second
@@ -24,7 +24,7 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmxutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmxutil"
 	"github.com/runtimeco/go-coap"
 )
 
@@ -71,6 +71,7 @@ func runTestRspCtor() NmpRsp       { return NewRunTestRsp() }
 func runListRspCtor() NmpRsp       { return NewRunListRsp() }
 func fsDownloadRspCtor() NmpRsp    { return NewFsDownloadRsp() }
 func fsUploadRspCtor() NmpRsp      { return NewFsUploadRsp() }
+func fsStatRspCtor() NmpRsp        { return NewFsStatRsp() }
 func configReadRspCtor() NmpRsp    { return NewConfigReadRsp() }
 func configWriteRspCtor() NmpRsp   { return NewConfigWriteRsp() }
 func shellExecRspCtor() NmpRsp     { return NewShellExecRsp() }
@@ -101,6 +102,7 @@ var rspCtorMap = map[Ogi]rspCtor{
 	{op_rr, gr_run, NMP_ID_RUN_LIST}:         runListRspCtor,
 	{op_rr, gr_fil, NMP_ID_FS_FILE}:          fsDownloadRspCtor,
 	{op_wr, gr_fil, NMP_ID_FS_FILE}:          fsUploadRspCtor,
+	{op_rr, gr_fil, NMP_ID_FS_STAT}:          fsStatRspCtor,
 	{op_rr, gr_cfg, NMP_ID_CONFIG_VAL}:       configReadRspCtor,
 	{op_wr, gr_cfg, NMP_ID_CONFIG_VAL}:       configWriteRspCtor,
 	{op_wr, gr_she, NMP_ID_SHELL_EXEC}:       shellExecRspCtor,
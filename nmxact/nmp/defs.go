@@ -106,6 +106,7 @@ const (
 // File system group (8).
 const (
 	NMP_ID_FS_FILE = 0
+	NMP_ID_FS_STAT = 1
 )
 
 // Shell group (8).
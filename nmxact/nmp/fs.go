@@ -84,3 +84,32 @@ func NewFsUploadRsp() *FsUploadRsp {
 }
 
 func (r *FsUploadRsp) Msg() *NmpMsg { return MsgFromReq(r) }
+
+//////////////////////////////////////////////////////////////////////////////
+// $stat                                                                    //
+//////////////////////////////////////////////////////////////////////////////
+
+type FsStatReq struct {
+	NmpBase `codec:"-"`
+	Name    string `codec:"name"`
+}
+
+type FsStatRsp struct {
+	NmpBase
+	Rc  int    `codec:"rc"`
+	Len uint32 `codec:"len"`
+}
+
+func NewFsStatReq() *FsStatReq {
+	r := &FsStatReq{}
+	fillNmpReq(r, NMP_OP_READ, NMP_GROUP_FS, NMP_ID_FS_STAT)
+	return r
+}
+
+func (r *FsStatReq) Msg() *NmpMsg { return MsgFromReq(r) }
+
+func NewFsStatRsp() *FsStatRsp {
+	return &FsStatRsp{}
+}
+
+func (r *FsStatRsp) Msg() *NmpMsg { return MsgFromReq(r) }
@@ -34,15 +34,28 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/tarm/serial"
 
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
 	"mynewt.apache.org/newt/util"
 )
 
+// Called for each line received from the device that is not part of a
+// management frame (i.e., console output).
+type ConsoleLineFn func(line []byte)
+
 type XportCfg struct {
 	DevPath     string
 	Baud        int
+	DataBits    int
+	Parity      serial.Parity
+	StopBits    serial.StopBits
 	Mtu         int
 	ReadTimeout time.Duration
+	ConsoleCb   ConsoleLineFn
+
+	// Pause between the segments of a single request.  Slower platforms
+	// take some time to process each segment and have very small receive
+	// buffers.
+	FrameDelay time.Duration
 }
 
 var errTimeout error = errors.New("Timeout reading from serial connection")
@@ -51,6 +64,10 @@ func NewXportCfg() *XportCfg {
 	return &XportCfg{
 		ReadTimeout: 10 * time.Second,
 		Mtu:         512,
+		DataBits:    8,
+		Parity:      serial.ParityNone,
+		StopBits:    serial.Stop1,
+		FrameDelay:  20 * time.Millisecond,
 	}
 }
 
@@ -110,6 +127,9 @@ func (sx *SerialXport) Start() error {
 		Name:        sx.cfg.DevPath,
 		Baud:        sx.cfg.Baud,
 		ReadTimeout: sx.cfg.ReadTimeout,
+		Size:        byte(sx.cfg.DataBits),
+		Parity:      sx.cfg.Parity,
+		StopBits:    sx.cfg.StopBits,
 	}
 
 	var err error
@@ -276,7 +296,7 @@ func (sx *SerialXport) Tx(bytes []byte) error {
 			/* slower platforms take some time to process each segment
 			 * and have very small receive buffers.  Give them a bit of
 			 * time here */
-			time.Sleep(20 * time.Millisecond)
+			time.Sleep(sx.cfg.FrameDelay)
 			sx.txRaw([]byte{4, 20})
 		}
 
@@ -314,6 +334,11 @@ func (sx *SerialXport) Rx() ([]byte, error) {
 		log.Debugf("Rx serial:\n%s", hex.Dump(line))
 		if len(line) < 2 || ((line[0] != 4 || line[1] != 20) &&
 			(line[0] != 6 || line[1] != 9)) {
+
+			// Not a management frame; pass it on as console output.
+			if sx.cfg.ConsoleCb != nil && len(line) > 0 {
+				sx.cfg.ConsoleCb(line)
+			}
 			continue
 		}
 
@@ -22,9 +22,9 @@ package sesn
 import (
 	"time"
 
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/bledefs"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/lora"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmcoap"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/bledefs"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/lora"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmcoap"
 )
 
 type MgmtProto int
@@ -47,6 +47,15 @@ func (r MgmtProto) String() string {
 
 type OnCloseFn func(s Sesn, err error)
 
+// Called when a BLE pairing procedure requires user interaction.  For passkey
+// input, the returned passkey is entered into the pairing procedure.  For
+// passkey display, the passkey argument must be shown to the user so that it
+// can be entered on the peer.  For numeric comparison, the passkey argument
+// must be compared against the number shown by the peer; the returned bool
+// indicates whether the user accepted it.
+type BlePasskeyFn func(action bledefs.BleSmAction, passkey uint32) (
+	uint32, bool, error)
+
 type PeerSpec struct {
 	Ble bledefs.BleDev
 	Udp string
@@ -65,6 +74,10 @@ type SesnCfgBle struct {
 	CloseTimeout time.Duration
 	WriteRsp     bool
 
+	// Handles passkey entry, display, and numeric comparison during pairing.
+	// If nil, only OOB pairing and just-works pairing are supported.
+	PasskeyCb BlePasskeyFn
+
 	// Central configuration.
 	Central SesnCfgBleCentral
 }
@@ -22,7 +22,7 @@ package xact
 import (
 	"fmt"
 
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
 )
 
 type Result interface {
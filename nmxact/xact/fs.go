@@ -22,9 +22,9 @@ package xact
 import (
 	"fmt"
 
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/mgmt"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmp"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/mgmt"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmp"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
 )
 
 //////////////////////////////////////////////////////////////////////////////
@@ -207,3 +207,45 @@ func (c *FsUploadCmd) Run(s sesn.Sesn) (Result, error) {
 
 	return res, nil
 }
+
+//////////////////////////////////////////////////////////////////////////////
+// $stat                                                                    //
+//////////////////////////////////////////////////////////////////////////////
+
+type FsStatCmd struct {
+	CmdBase
+	Name string
+}
+
+func NewFsStatCmd() *FsStatCmd {
+	return &FsStatCmd{
+		CmdBase: NewCmdBase(),
+	}
+}
+
+type FsStatResult struct {
+	Rsp *nmp.FsStatRsp
+}
+
+func newFsStatResult() *FsStatResult {
+	return &FsStatResult{}
+}
+
+func (r *FsStatResult) Status() int {
+	return r.Rsp.Rc
+}
+
+func (c *FsStatCmd) Run(s sesn.Sesn) (Result, error) {
+	r := nmp.NewFsStatReq()
+	r.Name = c.Name
+
+	rsp, err := txReq(s, r.Msg(), &c.CmdBase)
+	if err != nil {
+		return nil, err
+	}
+	srsp := rsp.(*nmp.FsStatRsp)
+
+	res := newFsStatResult()
+	res.Rsp = srsp
+	return res, nil
+}
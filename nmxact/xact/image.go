@@ -25,10 +25,10 @@ import (
 
 	pb "gopkg.in/cheggaaa/pb.v1"
 
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/mgmt"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmp"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/nmxutil"
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/mgmt"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmp"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/nmxutil"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
 )
 
 //////////////////////////////////////////////////////////////////////////////
@@ -37,6 +37,13 @@ import (
 const IMAGE_UPLOAD_MAX_CHUNK = 512
 const IMAGE_UPLOAD_MIN_1ST_CHUNK = 32
 
+// Uploads start with a conservative chunk size.  The size is doubled after a
+// run of successful chunks and halved whenever the peer times out or runs out
+// of memory, but never exceeds what fits in the transport MTU.
+const IMAGE_UPLOAD_START_CHUNK = 128
+const IMAGE_UPLOAD_MIN_CHUNK = 32
+const IMAGE_UPLOAD_GROW_AFTER = 8
+
 type ImageUploadProgressFn func(c *ImageUploadCmd, r *nmp.ImageUploadRsp)
 type ImageUploadCmd struct {
 	CmdBase
@@ -45,6 +52,19 @@ type ImageUploadCmd struct {
 	Upgrade    bool
 	ProgressCb ImageUploadProgressFn
 	ImageNum   int
+
+	// Upper bound on the chunk size; 0 means IMAGE_UPLOAD_MAX_CHUNK.
+	MaxChunkLen int
+
+	// Current chunk size limit; 0 means IMAGE_UPLOAD_START_CHUNK.  Updated as
+	// the upload progresses so that a retried upload can resume with it.
+	ChunkLen int
+
+	okCount int
+
+	// Set after a timeout has shrunk the chunk size; cleared by the next
+	// successful chunk.  Limits each run of timeouts to a single shrink.
+	timeoutShrunk bool
 }
 
 type ImageUploadResult struct {
@@ -107,11 +127,11 @@ func encodeUploadReq(s sesn.Sesn, hash []byte, upgrade bool, data []byte,
 }
 
 func findChunkLen(s sesn.Sesn, hash []byte, upgrade bool, data []byte,
-	off int, imageNum int, seq uint8) (int, error) {
+	off int, imageNum int, seq uint8, maxChunk int) (int, error) {
 
 	// Let's start by encoding max allowed chunk len and we will see how many
 	// bytes we need to cut
-	chunklen := min(len(data)-off, IMAGE_UPLOAD_MAX_CHUNK)
+	chunklen := min(len(data)-off, maxChunk)
 
 	// Keep reducing the chunk size until the request fits the MTU.
 	for {
@@ -132,8 +152,8 @@ func findChunkLen(s sesn.Sesn, hash []byte, upgrade bool, data []byte,
 	return chunklen, nil
 }
 
-func nextImageUploadReq(s sesn.Sesn, upgrade bool, data []byte, off int, imageNum int) (
-	*nmp.ImageUploadReq, error) {
+func nextImageUploadReq(s sesn.Sesn, upgrade bool, data []byte, off int,
+	imageNum int, maxChunk int) (*nmp.ImageUploadReq, error) {
 	var hash []byte = nil
 
 	// For 1st chunk we'll need valid data hash
@@ -145,7 +165,8 @@ func nextImageUploadReq(s sesn.Sesn, upgrade bool, data []byte, off int, imageNu
 	seq := nmxutil.NextNmpSeq()
 
 	// Find chunk length
-	chunklen, err := findChunkLen(s, hash, upgrade, data, off, imageNum, seq)
+	chunklen, err := findChunkLen(s, hash, upgrade, data, off, imageNum, seq,
+		maxChunk)
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +175,8 @@ func nextImageUploadReq(s sesn.Sesn, upgrade bool, data []byte, off int, imageNu
 	// fit we'll recalculate without hash
 	if off == 0 && chunklen < IMAGE_UPLOAD_MIN_1ST_CHUNK {
 		hash = nil
-		chunklen, err = findChunkLen(s, hash, upgrade, data, off, imageNum, seq)
+		chunklen, err = findChunkLen(s, hash, upgrade, data, off, imageNum, seq,
+			maxChunk)
 		if err != nil {
 			return nil, err
 		}
@@ -185,21 +207,80 @@ func nextImageUploadReq(s sesn.Sesn, upgrade bool, data []byte, off int, imageNu
 	return r, nil
 }
 
+func (c *ImageUploadCmd) maxChunkLen() int {
+	if c.MaxChunkLen > 0 {
+		return c.MaxChunkLen
+	}
+	return IMAGE_UPLOAD_MAX_CHUNK
+}
+
+// Halves the chunk size limit.  Returns false if the limit is already at its
+// minimum.
+func (c *ImageUploadCmd) shrinkChunk() bool {
+	c.okCount = 0
+	if c.ChunkLen <= IMAGE_UPLOAD_MIN_CHUNK {
+		return false
+	}
+
+	c.ChunkLen /= 2
+	if c.ChunkLen < IMAGE_UPLOAD_MIN_CHUNK {
+		c.ChunkLen = IMAGE_UPLOAD_MIN_CHUNK
+	}
+	return true
+}
+
+// Records a successfully uploaded chunk of the given size and doubles the
+// chunk size limit after enough consecutive successes.  Chunks that were cut
+// short by the MTU do not count; a larger limit would not help them.
+func (c *ImageUploadCmd) growChunk(chunkLen int) {
+	if chunkLen < c.ChunkLen {
+		return
+	}
+
+	c.okCount++
+	if c.okCount >= IMAGE_UPLOAD_GROW_AFTER {
+		c.okCount = 0
+		c.ChunkLen = min(c.ChunkLen*2, c.maxChunkLen())
+	}
+}
+
 func (c *ImageUploadCmd) Run(s sesn.Sesn) (Result, error) {
 	res := newImageUploadResult()
 
+	if c.ChunkLen <= 0 {
+		c.ChunkLen = IMAGE_UPLOAD_START_CHUNK
+	}
+	c.ChunkLen = min(c.ChunkLen, c.maxChunkLen())
+
 	for off := c.StartOff; off < len(c.Data); {
-		r, err := nextImageUploadReq(s, c.Upgrade, c.Data, off, c.ImageNum)
+		r, err := nextImageUploadReq(s, c.Upgrade, c.Data, off, c.ImageNum,
+			c.ChunkLen)
 		if err != nil {
 			return nil, err
 		}
 
 		rsp, err := txReq(s, r.Msg(), &c.CmdBase)
 		if err != nil {
+			// The peer may have dropped a chunk that was too large for it;
+			// retry the same offset with a smaller chunk.
+			if nmxutil.IsRspTimeout(err) && !c.timeoutShrunk &&
+				c.shrinkChunk() {
+
+				c.timeoutShrunk = true
+				continue
+			}
 			return nil, err
 		}
 		irsp := rsp.(*nmp.ImageUploadRsp)
 
+		if irsp.Rc == nmp.NMP_ERR_ENOMEM && c.shrinkChunk() {
+			continue
+		}
+		if irsp.Rc == 0 {
+			c.timeoutShrunk = false
+			c.growChunk(len(r.Data))
+		}
+
 		off = int(irsp.Off)
 
 		if c.ProgressCb != nil {
@@ -242,6 +323,7 @@ type ImageUpgradeCmd struct {
 	Upgrade     bool
 	ProgressBar *pb.ProgressBar
 	ImageNum    int
+	MaxChunkLen int
 }
 
 type ImageUpgradeResult struct {
@@ -304,6 +386,7 @@ func (c *ImageUpgradeCmd) runErase(s sesn.Sesn) (*ImageEraseResult, error) {
 
 func (c *ImageUpgradeCmd) runUpload(s sesn.Sesn) (*ImageUploadResult, error) {
 	startOff := 0
+	chunkLen := 0
 	progressCb := func(uc *ImageUploadCmd, r *nmp.ImageUploadRsp) {
 		if r.Rc == 0 {
 			startOff = int(r.Off)
@@ -318,9 +401,12 @@ func (c *ImageUpgradeCmd) runUpload(s sesn.Sesn) (*ImageUploadResult, error) {
 		cmd.Upgrade = c.Upgrade
 		cmd.ProgressCb = progressCb
 		cmd.ImageNum = c.ImageNum
+		cmd.MaxChunkLen = c.MaxChunkLen
+		cmd.ChunkLen = chunkLen
 		cmd.SetTxOptions(c.TxOptions())
 
 		res, err := cmd.Run(s)
+		chunkLen = cmd.ChunkLen
 		if err == nil {
 			return res.(*ImageUploadResult), nil
 		}
@@ -20,7 +20,7 @@
 package xport
 
 import (
-	"github.com/mfiumara/mynewt-newtmgr/nmxact/sesn"
+	"github.com/mfiumara/mynewt-newtmgr/v2/nmxact/sesn"
 )
 
 type RxFn func(data []byte)